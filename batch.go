@@ -0,0 +1,242 @@
+package airtable
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+)
+
+// batchChunkSize is the largest number of records Airtable's batch
+// create/update/upsert/delete endpoints accept per request.
+const batchChunkSize = 10
+
+// BatchOptions configures a batch write.
+type BatchOptions struct {
+	// Typecast enables Airtable's automatic data conversion, e.g.
+	// creating a new select option instead of erroring.
+	Typecast bool
+	// MergeOn names the fields UpsertBatch matches existing records
+	// on. Required by UpsertBatch, ignored otherwise.
+	MergeOn []string
+}
+
+// BatchError reports the outcome of a batch Create/Update/Upsert/
+// Delete call in which at least one record failed (up to and
+// including every record). Each chunk of up to 10 records is
+// submitted to Airtable as a single request, so a failure fails every
+// record in that chunk together.
+type BatchError struct {
+	// Failed maps the index of each failed record, into the slice
+	// passed by the caller, to the error its chunk failed with: the
+	// request's error, or an error reading the records or decoding
+	// Airtable's response.
+	Failed map[int]error
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("airtable: batch operation failed for %d of the submitted record(s)", len(e.Failed))
+}
+
+type batchRecordIn struct {
+	ID     string                 `json:"id,omitempty"`
+	Fields map[string]interface{} `json:"fields,omitempty"`
+}
+
+type batchWriteRequest struct {
+	Records       []batchRecordIn `json:"records"`
+	Typecast      bool            `json:"typecast,omitempty"`
+	PerformUpsert *performUpsert  `json:"performUpsert,omitempty"`
+}
+
+type performUpsert struct {
+	FieldsToMergeOn []string `json:"fieldsToMergeOn"`
+}
+
+type batchReadResponse struct {
+	Records []json.RawMessage `json:"records"`
+}
+
+type batchDeleteRecord struct {
+	ID      string `json:"id"`
+	Deleted bool   `json:"deleted"`
+}
+
+type batchDeleteResponse struct {
+	Records []batchDeleteRecord `json:"records"`
+}
+
+// CreateBatch creates the records pointed to by recordsPtr (a pointer
+// to a slice, as with List) in groups of up to 10, writing the
+// returned ID and CreatedTime back into each record via reflection,
+// mirroring Create. Chunks are submitted one at a time; a failed
+// chunk does not prevent the remaining chunks from being attempted,
+// and every failed record's index is reported via *BatchError.
+func (t *Table) CreateBatch(recordsPtr interface{}, opts *BatchOptions) error {
+	return t.CreateBatchContext(context.Background(), recordsPtr, opts)
+}
+
+// CreateBatchContext is CreateBatch with a caller-supplied context.
+func (t *Table) CreateBatchContext(ctx context.Context, recordsPtr interface{}, opts *BatchOptions) error {
+	return t.batchWrite(ctx, "POST", recordsPtr, opts, false)
+}
+
+// UpdateBatch updates the records pointed to by recordsPtr, identified
+// by their ID field, in groups of up to 10. See CreateBatch for
+// chunking and error-reporting behavior.
+func (t *Table) UpdateBatch(recordsPtr interface{}, opts *BatchOptions) error {
+	return t.UpdateBatchContext(context.Background(), recordsPtr, opts)
+}
+
+// UpdateBatchContext is UpdateBatch with a caller-supplied context.
+func (t *Table) UpdateBatchContext(ctx context.Context, recordsPtr interface{}, opts *BatchOptions) error {
+	return t.batchWrite(ctx, "PATCH", recordsPtr, opts, false)
+}
+
+// UpsertBatch creates or updates the records pointed to by recordsPtr
+// in groups of up to 10, matching existing records on opts.MergeOn.
+// See CreateBatch for chunking and error-reporting behavior.
+func (t *Table) UpsertBatch(recordsPtr interface{}, opts *BatchOptions) error {
+	return t.UpsertBatchContext(context.Background(), recordsPtr, opts)
+}
+
+// UpsertBatchContext is UpsertBatch with a caller-supplied context.
+func (t *Table) UpsertBatchContext(ctx context.Context, recordsPtr interface{}, opts *BatchOptions) error {
+	if opts == nil || len(opts.MergeOn) == 0 {
+		return errors.New("airtable: UpsertBatch requires opts.MergeOn")
+	}
+	return t.batchWrite(ctx, "PATCH", recordsPtr, opts, true)
+}
+
+// DeleteBatch deletes the records pointed to by recordsPtr, identified
+// by their ID field, in groups of up to 10. See CreateBatch for
+// chunking and error-reporting behavior.
+func (t *Table) DeleteBatch(recordsPtr interface{}) error {
+	return t.DeleteBatchContext(context.Background(), recordsPtr)
+}
+
+// DeleteBatchContext is DeleteBatch with a caller-supplied context.
+func (t *Table) DeleteBatchContext(ctx context.Context, recordsPtr interface{}) error {
+	list := reflect.ValueOf(recordsPtr).Elem()
+	batchErr := &BatchError{Failed: map[int]error{}}
+
+	for start := 0; start < list.Len(); start += batchChunkSize {
+		end := start + batchChunkSize
+		if end > list.Len() {
+			end = list.Len()
+		}
+		if err := t.deleteBatchChunk(ctx, list, start, end); err != nil {
+			for i := start; i < end; i++ {
+				batchErr.Failed[i] = err
+			}
+		}
+	}
+
+	if len(batchErr.Failed) > 0 {
+		return batchErr
+	}
+	return nil
+}
+
+func (t *Table) batchWrite(ctx context.Context, method string, recordsPtr interface{}, opts *BatchOptions, upsert bool) error {
+	if opts == nil {
+		opts = &BatchOptions{}
+	}
+	list := reflect.ValueOf(recordsPtr).Elem()
+	batchErr := &BatchError{Failed: map[int]error{}}
+
+	for start := 0; start < list.Len(); start += batchChunkSize {
+		end := start + batchChunkSize
+		if end > list.Len() {
+			end = list.Len()
+		}
+		if err := t.writeBatchChunk(ctx, method, list, start, end, opts, upsert); err != nil {
+			for i := start; i < end; i++ {
+				batchErr.Failed[i] = err
+			}
+		}
+	}
+
+	if len(batchErr.Failed) > 0 {
+		return batchErr
+	}
+	return nil
+}
+
+func (t *Table) writeBatchChunk(ctx context.Context, method string, list reflect.Value, start, end int, opts *BatchOptions, upsert bool) error {
+	records := make([]batchRecordIn, 0, end-start)
+	typecast := opts.Typecast
+	for i := start; i < end; i++ {
+		entry := list.Index(i).Addr().Interface()
+		fields, fieldTypecast, err := getFields(entry)
+		if err != nil {
+			return err
+		}
+		typecast = typecast || fieldTypecast
+		rec := batchRecordIn{Fields: fields}
+		if method != "POST" {
+			id, err := getID(entry)
+			if err != nil {
+				return err
+			}
+			rec.ID = id
+		}
+		records = append(records, rec)
+	}
+
+	req := batchWriteRequest{Records: records, Typecast: typecast}
+	if upsert {
+		req.PerformUpsert = &performUpsert{FieldsToMergeOn: opts.MergeOn}
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := t.client.RequestWithBodyContext(ctx, method, t.makePath(""), &Options{}, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	var parsed batchReadResponse
+	if err := json.Unmarshal(res, &parsed); err != nil {
+		return err
+	}
+	for i, raw := range parsed.Records {
+		entry := list.Index(start + i).Addr().Interface()
+		if err := decodeRecord(raw, entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *Table) deleteBatchChunk(ctx context.Context, list reflect.Value, start, end int) error {
+	u := url.Values{}
+	for i := start; i < end; i++ {
+		id, err := getID(list.Index(i).Addr().Interface())
+		if err != nil {
+			return err
+		}
+		u.Add("records[]", id)
+	}
+
+	res, err := t.client.RequestContext(ctx, "DELETE", t.makePath("")+"?"+u.Encode(), &Options{})
+	if err != nil {
+		return err
+	}
+
+	var parsed batchDeleteResponse
+	if err := json.Unmarshal(res, &parsed); err != nil {
+		return err
+	}
+	for _, r := range parsed.Records {
+		if !r.Deleted {
+			return fmt.Errorf("error: did not delete %s", r.ID)
+		}
+	}
+	return nil
+}
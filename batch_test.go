@@ -0,0 +1,255 @@
+package airtable
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type batchTestFields struct {
+	Name string `airtable:"Name"`
+}
+
+type batchTestRecord struct {
+	Record
+	Fields batchTestFields
+}
+
+func newBatchTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return &Client{
+		apiKey:      "key",
+		baseID:      "base",
+		httpClient:  srv.Client(),
+		baseURL:     srv.URL,
+		RetryPolicy: &RetryPolicy{MaxAttempts: 1},
+	}
+}
+
+// TestCreateBatchAcrossChunkBoundaryReportsPartialFailure drives 11
+// records through CreateBatch (a 10-record chunk and a 1-record
+// chunk), has the second chunk's request fail, and checks that the
+// first chunk's records get their IDs written back while the second
+// chunk's index is reported in the returned BatchError.
+func TestCreateBatchAcrossChunkBoundaryReportsPartialFailure(t *testing.T) {
+	var calls int
+	client := newBatchTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		var req batchWriteRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		if calls == 2 {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		resp := batchReadResponse{}
+		for i, rec := range req.Records {
+			raw, _ := json.Marshal(map[string]interface{}{
+				"id":          fmt.Sprintf("rec%d", i),
+				"createdTime": time.Unix(0, 0).UTC().Format(time.RFC3339),
+				"fields":      rec.Fields,
+			})
+			resp.Records = append(resp.Records, raw)
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	records := make([]batchTestRecord, 11)
+	for i := range records {
+		records[i].Fields.Name = fmt.Sprintf("Task %d", i)
+	}
+
+	table := client.Table("Tasks")
+	err := table.CreateBatch(&records, nil)
+	if calls != 2 {
+		t.Fatalf("calls = %d, want 2 chunks (10 + 1)", calls)
+	}
+
+	batchErr, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("CreateBatch() error = %v (%T), want *BatchError", err, err)
+	}
+	if len(batchErr.Failed) != 1 {
+		t.Fatalf("len(Failed) = %d, want 1 (only the second chunk's record)", len(batchErr.Failed))
+	}
+	if _, ok := batchErr.Failed[10]; !ok {
+		t.Fatalf("Failed = %+v, want index 10 present", batchErr.Failed)
+	}
+
+	for i := 0; i < 10; i++ {
+		if want := fmt.Sprintf("rec%d", i); records[i].ID != want {
+			t.Errorf("records[%d].ID = %q, want %q", i, records[i].ID, want)
+		}
+	}
+	if records[10].ID != "" {
+		t.Errorf("records[10].ID = %q, want empty since its chunk failed", records[10].ID)
+	}
+}
+
+// TestUpdateBatchAcrossChunkBoundaryWritesBackEveryChunk checks that
+// UpdateBatch chunks an 11-record slice into two requests and that
+// both chunks' reflected writes land back on the caller's slice.
+func TestUpdateBatchAcrossChunkBoundaryWritesBackEveryChunk(t *testing.T) {
+	var calls int
+	client := newBatchTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Method != http.MethodPatch {
+			t.Fatalf("method = %s, want PATCH", r.Method)
+		}
+		var req batchWriteRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		resp := batchReadResponse{}
+		for _, rec := range req.Records {
+			raw, _ := json.Marshal(map[string]interface{}{
+				"id":          rec.ID,
+				"createdTime": time.Unix(0, 0).UTC().Format(time.RFC3339),
+				"fields":      rec.Fields,
+			})
+			resp.Records = append(resp.Records, raw)
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	records := make([]batchTestRecord, 11)
+	for i := range records {
+		records[i].ID = fmt.Sprintf("rec%d", i)
+		records[i].Fields.Name = fmt.Sprintf("Updated %d", i)
+	}
+
+	table := client.Table("Tasks")
+	if err := table.UpdateBatch(&records, nil); err != nil {
+		t.Fatalf("UpdateBatch() error = %v, want nil", err)
+	}
+	if calls != 2 {
+		t.Fatalf("calls = %d, want 2 chunks (10 + 1)", calls)
+	}
+	for i := range records {
+		if want := fmt.Sprintf("rec%d", i); records[i].ID != want {
+			t.Errorf("records[%d].ID = %q, want %q", i, records[i].ID, want)
+		}
+		if want := time.Unix(0, 0); !records[i].CreatedTime.Equal(want) {
+			t.Errorf("records[%d].CreatedTime = %v, want %v written back from the response", i, records[i].CreatedTime, want)
+		}
+	}
+}
+
+// TestUpsertBatchRequiresMergeOn checks that UpsertBatch rejects a
+// missing or empty MergeOn before issuing any request, rather than
+// shipping a malformed performUpsert that Airtable would reject.
+func TestUpsertBatchRequiresMergeOn(t *testing.T) {
+	client := newBatchTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("UpsertBatch should not issue a request without MergeOn set")
+	})
+	records := []batchTestRecord{{}}
+	table := client.Table("Tasks")
+
+	if err := table.UpsertBatch(&records, nil); err == nil {
+		t.Fatal("UpsertBatch(nil opts) error = nil, want an error")
+	}
+	if err := table.UpsertBatch(&records, &BatchOptions{}); err == nil {
+		t.Fatal("UpsertBatch(opts with empty MergeOn) error = nil, want an error")
+	}
+}
+
+// TestUpsertBatchAcrossChunkBoundarySendsMergeOn checks that
+// UpsertBatch chunks an 11-record slice into two requests, sends
+// performUpsert.fieldsToMergeOn on each, and writes back the
+// resulting IDs.
+func TestUpsertBatchAcrossChunkBoundarySendsMergeOn(t *testing.T) {
+	var calls int
+	client := newBatchTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Method != http.MethodPatch {
+			t.Fatalf("method = %s, want PATCH", r.Method)
+		}
+		var req batchWriteRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		if req.PerformUpsert == nil || len(req.PerformUpsert.FieldsToMergeOn) == 0 {
+			t.Fatalf("PerformUpsert = %+v, want FieldsToMergeOn set", req.PerformUpsert)
+		}
+		resp := batchReadResponse{}
+		for i, rec := range req.Records {
+			raw, _ := json.Marshal(map[string]interface{}{
+				"id":          fmt.Sprintf("rec%d", calls*100+i),
+				"createdTime": time.Unix(0, 0).UTC().Format(time.RFC3339),
+				"fields":      rec.Fields,
+			})
+			resp.Records = append(resp.Records, raw)
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	records := make([]batchTestRecord, 11)
+	for i := range records {
+		records[i].Fields.Name = fmt.Sprintf("Task %d", i)
+	}
+
+	table := client.Table("Tasks")
+	opts := &BatchOptions{MergeOn: []string{"Name"}}
+	if err := table.UpsertBatch(&records, opts); err != nil {
+		t.Fatalf("UpsertBatch() error = %v, want nil", err)
+	}
+	if calls != 2 {
+		t.Fatalf("calls = %d, want 2 chunks (10 + 1)", calls)
+	}
+	for i := range records {
+		if records[i].ID == "" {
+			t.Errorf("records[%d].ID is empty, want the upserted ID written back", i)
+		}
+	}
+}
+
+// TestDeleteBatchAcrossChunkBoundaryReportsPartialFailure checks that
+// DeleteBatch chunks an 11-record slice into two requests and reports
+// the failing chunk's indices in the returned BatchError.
+func TestDeleteBatchAcrossChunkBoundaryReportsPartialFailure(t *testing.T) {
+	var calls int
+	client := newBatchTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Method != http.MethodDelete {
+			t.Fatalf("method = %s, want DELETE", r.Method)
+		}
+		ids := r.URL.Query()["records[]"]
+		if calls == 2 {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		resp := batchDeleteResponse{}
+		for _, id := range ids {
+			resp.Records = append(resp.Records, batchDeleteRecord{ID: id, Deleted: true})
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	records := make([]batchTestRecord, 11)
+	for i := range records {
+		records[i].ID = fmt.Sprintf("rec%d", i)
+	}
+
+	table := client.Table("Tasks")
+	err := table.DeleteBatch(&records)
+	if calls != 2 {
+		t.Fatalf("calls = %d, want 2 chunks (10 + 1)", calls)
+	}
+
+	batchErr, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("DeleteBatch() error = %v (%T), want *BatchError", err, err)
+	}
+	if len(batchErr.Failed) != 1 {
+		t.Fatalf("len(Failed) = %d, want 1 (only the second chunk's record)", len(batchErr.Failed))
+	}
+	if _, ok := batchErr.Failed[10]; !ok {
+		t.Fatalf("Failed = %+v, want index 10 present", batchErr.Failed)
+	}
+}
@@ -0,0 +1,170 @@
+package airtable
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const apiBaseURL = "https://api.airtable.com/v0"
+
+// defaultRatePerSec is Airtable's documented per-base rate limit.
+const defaultRatePerSec = 5
+
+// Client is an Airtable API client bound to a single base.
+type Client struct {
+	apiKey string
+	baseID string
+
+	httpClient *http.Client
+	// baseURL overrides apiBaseURL when set, letting tests point a
+	// Client at an httptest.Server instead of the real API.
+	baseURL string
+
+	// Limiter throttles outgoing requests made through this Client.
+	// It's shared across all of the Client's Table methods and safe
+	// for concurrent use, so concurrent callers draw from one rate
+	// budget instead of each independently tripping 429s. Defaults to
+	// Airtable's documented 5 req/sec per base; set to nil to disable.
+	Limiter *Limiter
+	// RetryPolicy controls how failed requests are retried. Defaults
+	// to DefaultRetryPolicy.
+	RetryPolicy *RetryPolicy
+}
+
+// New returns a Client for the given base, authenticating with apiKey.
+func New(apiKey, baseID string) *Client {
+	return &Client{
+		apiKey:      apiKey,
+		baseID:      baseID,
+		httpClient:  http.DefaultClient,
+		Limiter:     NewLimiter(defaultRatePerSec, defaultRatePerSec),
+		RetryPolicy: DefaultRetryPolicy(),
+	}
+}
+
+// Request issues an HTTP request against the Airtable API and returns
+// the raw response body.
+func (c *Client) Request(method, path string, options *Options) ([]byte, error) {
+	return c.RequestContext(context.Background(), method, path, options)
+}
+
+// RequestContext is Request with a caller-supplied context, letting
+// callers bound or cancel a single request.
+func (c *Client) RequestContext(ctx context.Context, method, path string, options *Options) ([]byte, error) {
+	return c.RequestWithBodyContext(ctx, method, path, options, nil)
+}
+
+// RequestWithBody issues an HTTP request with a body against the
+// Airtable API and returns the raw response body, retrying per
+// c.RetryPolicy and honoring c.Limiter's rate budget on every
+// attempt.
+func (c *Client) RequestWithBody(method, path string, options *Options, body io.Reader) ([]byte, error) {
+	return c.RequestWithBodyContext(context.Background(), method, path, options, body)
+}
+
+// RequestWithBodyContext is RequestWithBody with a caller-supplied
+// context, letting callers cancel a request (or an in-flight List
+// pagination loop) instead of waiting it out.
+func (c *Client) RequestWithBodyContext(ctx context.Context, method, path string, options *Options, body io.Reader) ([]byte, error) {
+	var bodyBytes []byte
+	if body != nil {
+		b, err := io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		bodyBytes = b
+	}
+
+	base := c.baseURL
+	if base == "" {
+		base = apiBaseURL
+	}
+	u := base + "/" + c.baseID + "/" + path
+	if q := encodeOptions(options); q != "" {
+		u += "?" + q
+	}
+
+	policy := c.RetryPolicy
+	if policy == nil {
+		policy = DefaultRetryPolicy()
+	}
+	attempts := policy.MaxAttempts
+	if attempts <= 0 {
+		attempts = 1
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if c.Limiter != nil {
+			if err := c.Limiter.WaitContext(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		var reqBody io.Reader
+		if bodyBytes != nil {
+			reqBody = bytes.NewReader(bodyBytes)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, u, reqBody)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+		if bodyBytes != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		res, err := c.httpClient.Do(req)
+		if err != nil {
+			lastErr = err
+			// A transport error means no response was received, so we
+			// can't tell whether Airtable already applied the request.
+			// Retrying a non-idempotent write (POST) could double-create
+			// records, so only retry idempotent methods here.
+			if attempt == attempts || !isIdempotentMethod(method) {
+				break
+			}
+			if err := sleepContext(ctx, policy.backoff(attempt, 0)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		resBytes, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if res.StatusCode >= 300 {
+			lastErr = fmt.Errorf("airtable: %s %s: %s: %s", method, path, res.Status, resBytes)
+			if attempt == attempts || !policy.retryable(res.StatusCode) {
+				return nil, lastErr
+			}
+			if err := sleepContext(ctx, policy.backoff(attempt, parseRetryAfter(res.Header.Get("Retry-After")))); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		return resBytes, nil
+	}
+	return nil, lastErr
+}
+
+// sleepContext waits for d, returning early with ctx.Err() if ctx is
+// done first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
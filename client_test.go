@@ -0,0 +1,65 @@
+package airtable
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newRequestTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return &Client{
+		apiKey:     "key",
+		baseID:     "base",
+		httpClient: srv.Client(),
+		baseURL:    srv.URL,
+		RetryPolicy: &RetryPolicy{
+			MaxAttempts: 3,
+			BaseDelay:   time.Millisecond,
+			MaxDelay:    5 * time.Millisecond,
+		},
+	}
+}
+
+func TestRequestWithBodyContextRetriesAfterRateLimit(t *testing.T) {
+	var calls int
+	client := newRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"ok":true}`))
+	})
+
+	res, err := client.Request("GET", "Tasks", nil)
+	if err != nil {
+		t.Fatalf("Request() error = %v, want nil after the rate-limited attempt succeeds on retry", err)
+	}
+	if string(res) != `{"ok":true}` {
+		t.Fatalf("Request() = %q, want the second attempt's body", res)
+	}
+	if calls != 2 {
+		t.Fatalf("calls = %d, want 2 (one 429, one success)", calls)
+	}
+}
+
+func TestRequestWithBodyContextExhaustsMaxAttemptsOn5xx(t *testing.T) {
+	var calls int
+	client := newRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := client.Request("GET", "Tasks", nil)
+	if err == nil {
+		t.Fatal("Request() error = nil, want an error once every attempt returns 503")
+	}
+	if calls != client.RetryPolicy.MaxAttempts {
+		t.Fatalf("calls = %d, want %d (MaxAttempts)", calls, client.RetryPolicy.MaxAttempts)
+	}
+}
@@ -0,0 +1,85 @@
+package airtable
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// slowSecondPageServer serves a first page immediately, pointing at a
+// second page, then stalls every later request well past the
+// context's deadline so a canceled/timed-out context can be observed
+// aborting mid-pagination instead of completing or hanging.
+func slowSecondPageServer(t *testing.T) (*Client, *int) {
+	t.Helper()
+	calls := 0
+	client := newIteratorTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			var resp struct {
+				Records []json.RawMessage `json:"records"`
+				Offset  string            `json:"offset"`
+			}
+			raw, _ := json.Marshal(map[string]interface{}{
+				"id":          "a",
+				"createdTime": "2020-01-01T00:00:00Z",
+				"fields":      map[string]interface{}{"Name": "a"},
+			})
+			resp.Records = append(resp.Records, raw)
+			resp.Offset = "page-1"
+			json.NewEncoder(w).Encode(resp)
+			return
+		}
+
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+	return client, &calls
+}
+
+func TestListContextAbortsMidPagination(t *testing.T) {
+	client, calls := slowSecondPageServer(t)
+	table := client.Table("Tasks")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	var records []iteratorTestRecord
+	err := table.ListContext(ctx, &records, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("ListContext() error = %v, want context.DeadlineExceeded", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("records = %+v, want the first page's single record despite the aborted second page", records)
+	}
+	if *calls != 2 {
+		t.Fatalf("calls = %d, want 2 (first page served, second page aborted)", *calls)
+	}
+}
+
+func TestIteratorNextAbortsMidPagination(t *testing.T) {
+	client, calls := slowSecondPageServer(t)
+	table := client.Table("Tasks")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	it := table.IterateContext(ctx, &iteratorTestRecord{}, nil)
+	if !it.Next() {
+		t.Fatalf("Next() = false on the first record, err = %v", it.Err())
+	}
+	if it.Next() {
+		t.Fatal("Next() = true fetching the stalled second page, want false once the context deadline passes")
+	}
+	if !errors.Is(it.Err(), context.DeadlineExceeded) {
+		t.Fatalf("Err() = %v, want context.DeadlineExceeded", it.Err())
+	}
+	if *calls != 2 {
+		t.Fatalf("calls = %d, want 2 (first page served, second page aborted)", *calls)
+	}
+}
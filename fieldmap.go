@@ -0,0 +1,108 @@
+package airtable
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// fieldMapping describes how one Go struct field maps onto an
+// Airtable column.
+type fieldMapping struct {
+	goName    string
+	column    string
+	omitempty bool
+	readonly  bool
+	typecast  bool
+}
+
+// fieldMap is the parsed `airtable:"..."` tag information for one
+// Fields struct type, built once per type and cached by getFieldMap so
+// repeated requests don't re-walk the struct's fields.
+type fieldMap struct {
+	byGoName map[string]fieldMapping
+	byColumn map[string]fieldMapping
+	ordered  []fieldMapping
+}
+
+var fieldMapCache sync.Map // map[reflect.Type]*fieldMap
+
+// getFieldMap returns the cached fieldMap for typ, a Fields struct
+// type, building it on first use.
+//
+// Fields are mapped to their own Go name by default. An
+// `airtable:"Column Name"` tag overrides the column a field reads
+// from and writes to, and accepts comma-separated options:
+// "omitempty" (skip the field on write when it's the zero value),
+// "readonly" (never send the field on write), and "typecast" (ask
+// Airtable to typecast the whole record when this field is written).
+func getFieldMap(typ reflect.Type) *fieldMap {
+	if cached, ok := fieldMapCache.Load(typ); ok {
+		return cached.(*fieldMap)
+	}
+
+	fm := &fieldMap{
+		byGoName: map[string]fieldMapping{},
+		byColumn: map[string]fieldMapping{},
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		m := fieldMapping{goName: f.Name, column: f.Name}
+
+		if tag, ok := f.Tag.Lookup("airtable"); ok {
+			parts := strings.Split(tag, ",")
+			if parts[0] != "" {
+				m.column = parts[0]
+			}
+			for _, opt := range parts[1:] {
+				switch opt {
+				case "omitempty":
+					m.omitempty = true
+				case "readonly":
+					m.readonly = true
+				case "typecast":
+					m.typecast = true
+				}
+			}
+		}
+		if m.column == "-" {
+			continue
+		}
+
+		fm.byGoName[m.goName] = m
+		fm.byColumn[m.column] = m
+		fm.ordered = append(fm.ordered, m)
+	}
+
+	actual, _ := fieldMapCache.LoadOrStore(typ, fm)
+	return actual.(*fieldMap)
+}
+
+// encodeFields renders fieldsVal (a record's Fields struct) into the
+// map of Airtable column name to value that belongs in a write
+// request body, skipping readonly fields and any omitempty field
+// holding its zero value. It also reports whether Airtable's
+// typecast option should be set for this write.
+func encodeFields(fieldsVal reflect.Value) (map[string]interface{}, bool) {
+	fm := getFieldMap(fieldsVal.Type())
+
+	out := map[string]interface{}{}
+	typecast := false
+	for _, m := range fm.ordered {
+		if m.readonly {
+			continue
+		}
+		fv := fieldsVal.FieldByName(m.goName)
+		if m.omitempty && fv.IsZero() {
+			continue
+		}
+		if m.typecast {
+			typecast = true
+		}
+		out[m.column] = fv.Interface()
+	}
+	return out, typecast
+}
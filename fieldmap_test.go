@@ -0,0 +1,88 @@
+package airtable
+
+import (
+	"reflect"
+	"testing"
+)
+
+type fieldMapTestFields struct {
+	Name     string `airtable:"Name"`
+	DueDate  string `airtable:"Due Date,omitempty"`
+	Internal string `airtable:"-"`
+	ReadOnly string `airtable:"Computed,readonly"`
+	Typecast string `airtable:"Choice,typecast"`
+	Plain    string
+}
+
+func TestGetFieldMapParsesTagOptions(t *testing.T) {
+	fm := getFieldMap(reflect.TypeOf(fieldMapTestFields{}))
+
+	dueDate, ok := fm.byGoName["DueDate"]
+	if !ok || dueDate.column != "Due Date" || !dueDate.omitempty {
+		t.Fatalf("DueDate mapping = %+v, ok = %v, want column %q omitempty true", dueDate, ok, "Due Date")
+	}
+
+	readOnly, ok := fm.byColumn["Computed"]
+	if !ok || !readOnly.readonly {
+		t.Fatalf("Computed mapping = %+v, ok = %v, want readonly true", readOnly, ok)
+	}
+
+	typecast, ok := fm.byColumn["Choice"]
+	if !ok || !typecast.typecast {
+		t.Fatalf("Choice mapping = %+v, ok = %v, want typecast true", typecast, ok)
+	}
+
+	if _, ok := fm.byGoName["Internal"]; ok {
+		t.Fatal("field tagged airtable:\"-\" should be skipped, but was mapped")
+	}
+
+	plain, ok := fm.byGoName["Plain"]
+	if !ok || plain.column != "Plain" {
+		t.Fatalf("untagged field should default its column to its Go name, got %+v", plain)
+	}
+}
+
+type fieldMapUnexportedFields struct {
+	Name   string `airtable:"Name"`
+	cached string
+}
+
+func TestGetFieldMapSkipsUnexportedFields(t *testing.T) {
+	fm := getFieldMap(reflect.TypeOf(fieldMapUnexportedFields{}))
+
+	if _, ok := fm.byGoName["cached"]; ok {
+		t.Fatal("unexported field should be skipped, but was mapped")
+	}
+
+	v := fieldMapUnexportedFields{Name: "Task", cached: "anything"}
+	cols, _ := encodeFields(reflect.ValueOf(v))
+	if cols["Name"] != "Task" {
+		t.Fatalf("Name = %v, want %q", cols["Name"], "Task")
+	}
+	if len(cols) != 1 {
+		t.Fatalf("cols = %+v, want only Name (unexported field must not appear)", cols)
+	}
+}
+
+func TestEncodeFieldsSkipsReadonlyAndEmptyOmitempty(t *testing.T) {
+	v := fieldMapTestFields{
+		Name:     "Task",
+		DueDate:  "",
+		ReadOnly: "should not be sent",
+		Typecast: "Other",
+	}
+	cols, typecast := encodeFields(reflect.ValueOf(v))
+
+	if _, ok := cols["Due Date"]; ok {
+		t.Fatal("omitempty field with zero value should be omitted")
+	}
+	if _, ok := cols["Computed"]; ok {
+		t.Fatal("readonly field should never be sent")
+	}
+	if cols["Name"] != "Task" {
+		t.Fatalf("Name = %v, want %q", cols["Name"], "Task")
+	}
+	if !typecast {
+		t.Fatal("typecast should be true when a typecast-tagged field is set")
+	}
+}
@@ -0,0 +1,35 @@
+package airtable
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/denton/airtable/formula"
+)
+
+// validateFilterFields confirms every column referenced by expr maps
+// to a field on recordType's embedded Fields struct by column name
+// (the airtable tag, or the Go name of an untagged field; the Go name
+// of a tagged field is not accepted), the same fieldMap NewRecord and
+// the wire (de)coders
+// use, so a typo in a filter surfaces at query-build time instead of
+// after a round trip to Airtable.
+func validateFilterFields(recordType reflect.Type, expr formula.Expr) error {
+	fieldsType, ok := recordType.FieldByName("Fields")
+	if !ok {
+		return fmt.Errorf("validateFilterFields: %s has no Fields", recordType)
+	}
+	fm := getFieldMap(fieldsType.Type)
+
+	var unknown []string
+	for _, name := range formula.FieldsIn(expr) {
+		if _, ok := fm.byColumn[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("airtable: unknown filter field(s) on %s: %s", recordType, strings.Join(unknown, ", "))
+	}
+	return nil
+}
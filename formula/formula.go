@@ -0,0 +1,199 @@
+// Package formula builds Airtable filterByFormula expressions from a
+// typed AST instead of hand-written strings, so typos in field names
+// and quoting mistakes are caught by the compiler and by
+// airtable.Table.List's field validation instead of surfacing as an
+// opaque 422 from the API.
+package formula
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Expr is a node in a filterByFormula expression tree. Compile
+// renders it to Airtable's formula syntax.
+type Expr interface {
+	Compile() string
+}
+
+// Field references an Airtable column by name.
+type Field struct {
+	Name string
+}
+
+// Compile renders the field reference, escaping any literal closing
+// brace in the column name.
+func (f Field) Compile() string {
+	return "{" + strings.ReplaceAll(f.Name, "}", "\\}") + "}"
+}
+
+// Literal is a constant value: a string, number, bool, or time.Time.
+type Literal struct {
+	Value interface{}
+}
+
+// Compile renders the literal using Airtable's syntax for its type:
+// single-quoted and escaped for strings, an ISO-8601 datetime for
+// time.Time, and TRUE()/FALSE() for bools.
+func (l Literal) Compile() string {
+	switch v := l.Value.(type) {
+	case string:
+		return "'" + strings.ReplaceAll(v, "'", "\\'") + "'"
+	case bool:
+		if v {
+			return "TRUE()"
+		}
+		return "FALSE()"
+	case time.Time:
+		return "'" + v.UTC().Format(time.RFC3339) + "'"
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// Binary is a two-operand infix expression, e.g. `({A} = {B})`.
+type Binary struct {
+	Op    string
+	Left  Expr
+	Right Expr
+}
+
+// Compile renders the infix expression, parenthesized.
+func (b Binary) Compile() string {
+	return fmt.Sprintf("(%s %s %s)", b.Left.Compile(), b.Op, b.Right.Compile())
+}
+
+// Unary is a single-operand prefix expression, e.g. `NOT(...)`.
+type Unary struct {
+	Op      string
+	Operand Expr
+}
+
+// Compile renders the prefix expression.
+func (u Unary) Compile() string {
+	return fmt.Sprintf("%s(%s)", u.Op, u.Operand.Compile())
+}
+
+// Func is a call to an Airtable formula function with any number of
+// arguments, e.g. `AND(a, b, c)`.
+type Func struct {
+	Name string
+	Args []Expr
+}
+
+// Compile renders the function call.
+func (fn Func) Compile() string {
+	args := make([]string, len(fn.Args))
+	for i, a := range fn.Args {
+		args[i] = a.Compile()
+	}
+	return fmt.Sprintf("%s(%s)", fn.Name, strings.Join(args, ", "))
+}
+
+// val coerces v into an Expr, passing one through unchanged and
+// wrapping anything else as a Literal, so builders can accept either
+// a nested expression or a plain Go value.
+func val(v interface{}) Expr {
+	if e, ok := v.(Expr); ok {
+		return e
+	}
+	return Literal{Value: v}
+}
+
+// FieldRef returns an Expr referencing the named column.
+func FieldRef(name string) Expr {
+	return Field{Name: name}
+}
+
+// Eq returns `{field} = value`.
+func Eq(field string, value interface{}) Expr {
+	return Binary{Op: "=", Left: Field{Name: field}, Right: val(value)}
+}
+
+// NotEq returns `{field} != value`.
+func NotEq(field string, value interface{}) Expr {
+	return Binary{Op: "!=", Left: Field{Name: field}, Right: val(value)}
+}
+
+// Gt returns `{field} > value`.
+func Gt(field string, value interface{}) Expr {
+	return Binary{Op: ">", Left: Field{Name: field}, Right: val(value)}
+}
+
+// Gte returns `{field} >= value`.
+func Gte(field string, value interface{}) Expr {
+	return Binary{Op: ">=", Left: Field{Name: field}, Right: val(value)}
+}
+
+// Lt returns `{field} < value`.
+func Lt(field string, value interface{}) Expr {
+	return Binary{Op: "<", Left: Field{Name: field}, Right: val(value)}
+}
+
+// Lte returns `{field} <= value`.
+func Lte(field string, value interface{}) Expr {
+	return Binary{Op: "<=", Left: Field{Name: field}, Right: val(value)}
+}
+
+// And returns `AND(exprs...)`.
+func And(exprs ...Expr) Expr {
+	return Func{Name: "AND", Args: exprs}
+}
+
+// Or returns `OR(exprs...)`.
+func Or(exprs ...Expr) Expr {
+	return Func{Name: "OR", Args: exprs}
+}
+
+// Not returns `NOT(expr)`.
+func Not(expr Expr) Expr {
+	return Unary{Op: "NOT", Operand: expr}
+}
+
+// Find returns `FIND(needle, {field})`, Airtable's substring search.
+func Find(needle string, field string) Expr {
+	return Func{Name: "FIND", Args: []Expr{Literal{Value: needle}, Field{Name: field}}}
+}
+
+// DateIsSame returns `IS_SAME({field}, value, 'unit')`, Airtable's
+// date-granularity comparison (unit is e.g. "day", "month", "year").
+func DateIsSame(field string, value time.Time, unit string) Expr {
+	return Func{Name: "IS_SAME", Args: []Expr{Field{Name: field}, Literal{Value: value}, Literal{Value: unit}}}
+}
+
+// FieldsIn returns the distinct field names referenced anywhere in
+// expr, so callers can validate them before issuing a request.
+func FieldsIn(expr Expr) []string {
+	seen := map[string]bool{}
+	var walk func(Expr)
+	walk = func(e Expr) {
+		switch n := e.(type) {
+		case Field:
+			seen[n.Name] = true
+		case Binary:
+			walk(n.Left)
+			walk(n.Right)
+		case Unary:
+			walk(n.Operand)
+		case Func:
+			for _, a := range n.Args {
+				walk(a)
+			}
+		}
+	}
+	walk(expr)
+
+	names := make([]string, 0, len(seen))
+	for n := range seen {
+		names = append(names, n)
+	}
+	return names
+}
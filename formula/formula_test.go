@@ -0,0 +1,76 @@
+package formula
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFieldCompileEscapesBrace(t *testing.T) {
+	got := Field{Name: "Foo}Bar"}.Compile()
+	want := `{Foo\}Bar}`
+	if got != want {
+		t.Fatalf("Compile() = %q, want %q", got, want)
+	}
+}
+
+func TestLiteralCompile(t *testing.T) {
+	cases := []struct {
+		name string
+		in   interface{}
+		want string
+	}{
+		{"string", "it's fine", `'it\'s fine'`},
+		{"true", true, "TRUE()"},
+		{"false", false, "FALSE()"},
+		{"int", 42, "42"},
+		{"time", time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), "'2024-01-02T03:04:05Z'"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := Literal{Value: c.in}.Compile()
+			if got != c.want {
+				t.Fatalf("Compile() = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestEqCompile(t *testing.T) {
+	got := Eq("Status", "Done").Compile()
+	want := "({Status} = 'Done')"
+	if got != want {
+		t.Fatalf("Compile() = %q, want %q", got, want)
+	}
+}
+
+func TestAndOrNotCompile(t *testing.T) {
+	expr := And(Eq("A", 1), Or(Eq("B", 2), Not(Eq("C", 3))))
+	got := expr.Compile()
+	want := "AND(({A} = 1), OR(({B} = 2), NOT(({C} = 3))))"
+	if got != want {
+		t.Fatalf("Compile() = %q, want %q", got, want)
+	}
+}
+
+func TestFindCompile(t *testing.T) {
+	got := Find("needle", "Haystack").Compile()
+	want := "FIND('needle', {Haystack})"
+	if got != want {
+		t.Fatalf("Compile() = %q, want %q", got, want)
+	}
+}
+
+func TestFieldsInCollectsEveryReferencedField(t *testing.T) {
+	expr := And(Eq("A", 1), Or(Gt("B", 2), Not(NotEq("C", 3))))
+	names := FieldsIn(expr)
+
+	want := map[string]bool{"A": true, "B": true, "C": true}
+	if len(names) != len(want) {
+		t.Fatalf("FieldsIn() = %v, want fields %v", names, want)
+	}
+	for _, n := range names {
+		if !want[n] {
+			t.Fatalf("FieldsIn() returned unexpected field %q", n)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package airtable
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+)
+
+// Iterator fetches List results one page at a time, so large tables
+// can be processed in constant memory and abandoned early without
+// waiting for every page to load. Use Table.Iterate to obtain one.
+type Iterator struct {
+	ctx        context.Context
+	table      *Table
+	options    *Options
+	recordType reflect.Type
+
+	page   reflect.Value // current page, invalid until the first Next
+	index  int
+	offset string
+	done   bool
+	err    error
+}
+
+// Iterate returns an Iterator over the table, unmarshaling each
+// record into recordType, which (like List's listPtr) is used only to
+// learn the element type and may be a pointer to a zero value of the
+// record struct.
+func (t *Table) Iterate(recordType interface{}, options *Options) *Iterator {
+	return t.IterateContext(context.Background(), recordType, options)
+}
+
+// IterateContext is Iterate with a caller-supplied context, threaded
+// through every page fetched by the returned Iterator so it can be
+// canceled mid-iteration.
+func (t *Table) IterateContext(ctx context.Context, recordType interface{}, options *Options) *Iterator {
+	if options == nil {
+		options = &Options{}
+	}
+	typ := reflect.TypeOf(recordType)
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	it := &Iterator{
+		ctx:        ctx,
+		table:      t,
+		options:    options,
+		recordType: typ,
+		index:      -1,
+	}
+	if options.Filter != nil {
+		it.err = validateFilterFields(typ, options.Filter)
+	}
+	return it
+}
+
+// Next advances to the next record, fetching the next page from
+// Airtable if the current one is exhausted. It returns false once
+// iteration is complete or an error occurs; check Err to tell them
+// apart.
+func (it *Iterator) Next() bool {
+	if it.done || it.err != nil {
+		return false
+	}
+	it.index++
+	for !it.page.IsValid() || it.index >= it.page.Len() {
+		if it.page.IsValid() && it.offset == "" {
+			it.done = true
+			return false
+		}
+		if !it.fetchPage() {
+			return false
+		}
+		it.index = 0
+	}
+	return true
+}
+
+func (it *Iterator) fetchPage() bool {
+	it.options.typ = it.recordType
+	it.options.offset = it.offset
+
+	bytes, err := it.table.client.RequestContext(it.ctx, "GET", it.table.makePath(""), it.options)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	var page struct {
+		Records []json.RawMessage `json:"records"`
+		Offset  string            `json:"offset"`
+	}
+	if err := json.Unmarshal(bytes, &page); err != nil {
+		it.err = err
+		return false
+	}
+
+	records := reflect.MakeSlice(reflect.SliceOf(it.recordType), len(page.Records), len(page.Records))
+	for i, raw := range page.Records {
+		entry := reflect.New(it.recordType)
+		if err := decodeRecord(raw, entry.Interface()); err != nil {
+			it.err = err
+			return false
+		}
+		records.Index(i).Set(entry.Elem())
+	}
+
+	it.page = records
+	it.offset = page.Offset
+	return true
+}
+
+// Scan copies the current record into dst, which must be a pointer to
+// the recordType passed to Iterate.
+func (it *Iterator) Scan(dst interface{}) error {
+	if !it.page.IsValid() || it.index < 0 || it.index >= it.page.Len() {
+		return errors.New("airtable: Scan called without a successful Next")
+	}
+	reflect.ValueOf(dst).Elem().Set(it.page.Index(it.index))
+	return nil
+}
+
+// Err returns the first error encountered during iteration, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close stops the iterator so it fetches no further pages. Safe to
+// call more than once.
+func (it *Iterator) Close() error {
+	it.done = true
+	return nil
+}
@@ -0,0 +1,129 @@
+package airtable
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type iteratorTestFields struct {
+	Name string `airtable:"Name"`
+}
+
+type iteratorTestRecord struct {
+	Record
+	Fields iteratorTestFields
+}
+
+func newIteratorTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return &Client{
+		apiKey:     "key",
+		baseID:     "base",
+		httpClient: srv.Client(),
+		baseURL:    srv.URL,
+	}
+}
+
+// pagedIteratorServer serves pages keyed by the offset query param,
+// mimicking Airtable's cursor-based pagination: the first request has
+// no offset, and each response's Offset points at the next page until
+// the last page, which omits it.
+func pagedIteratorServer(t *testing.T, pages [][]string) (*Client, *int) {
+	t.Helper()
+	calls := 0
+	client := newIteratorTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		offset := r.URL.Query().Get("offset")
+		idx := 0
+		if offset != "" {
+			fmt.Sscanf(offset, "page-%d", &idx)
+		}
+
+		var resp struct {
+			Records []json.RawMessage `json:"records"`
+			Offset  string            `json:"offset"`
+		}
+		for _, name := range pages[idx] {
+			raw, _ := json.Marshal(map[string]interface{}{
+				"id":          name,
+				"createdTime": "2020-01-01T00:00:00Z",
+				"fields":      map[string]interface{}{"Name": name},
+			})
+			resp.Records = append(resp.Records, raw)
+		}
+		if idx+1 < len(pages) {
+			resp.Offset = fmt.Sprintf("page-%d", idx+1)
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+	return client, &calls
+}
+
+func TestIteratorWalksMultiplePages(t *testing.T) {
+	client, calls := pagedIteratorServer(t, [][]string{{"a", "b"}, {"c"}})
+	table := client.Table("Tasks")
+
+	it := table.Iterate(&iteratorTestRecord{}, nil)
+	var got []string
+	for it.Next() {
+		var rec iteratorTestRecord
+		if err := it.Scan(&rec); err != nil {
+			t.Fatalf("Scan() error = %v", err)
+		}
+		got = append(got, rec.Fields.Name)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Err() = %v, want nil", err)
+	}
+
+	want := []string{"a", "b", "c"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+	if *calls != 2 {
+		t.Fatalf("calls = %d, want 2 pages fetched", *calls)
+	}
+	if it.Next() {
+		t.Fatal("Next() = true after exhausting every page, want false")
+	}
+}
+
+func TestIteratorScanWithoutNextErrors(t *testing.T) {
+	client, _ := pagedIteratorServer(t, [][]string{{"a"}})
+	table := client.Table("Tasks")
+
+	it := table.Iterate(&iteratorTestRecord{}, nil)
+	var rec iteratorTestRecord
+	if err := it.Scan(&rec); err == nil {
+		t.Fatal("Scan() error = nil before any Next(), want an error")
+	}
+}
+
+func TestIteratorCloseStopsFetchingFurtherPages(t *testing.T) {
+	client, calls := pagedIteratorServer(t, [][]string{{"a", "b"}, {"c"}})
+	table := client.Table("Tasks")
+
+	it := table.Iterate(&iteratorTestRecord{}, nil)
+	if !it.Next() {
+		t.Fatalf("Next() = false on first record, err = %v", it.Err())
+	}
+	if err := it.Close(); err != nil {
+		t.Fatalf("Close() error = %v, want nil", err)
+	}
+	if it.Next() {
+		t.Fatal("Next() = true after Close(), want false")
+	}
+	if *calls != 1 {
+		t.Fatalf("calls = %d, want 1 (no further page fetched after Close)", *calls)
+	}
+}
@@ -0,0 +1,71 @@
+package airtable
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/denton/airtable/formula"
+)
+
+// Options configures a List request.
+type Options struct {
+	// MaxRecords caps the total number of records returned across all
+	// pages.
+	MaxRecords int
+	// PageSize sets the number of records per page (Airtable's default
+	// and max is 100).
+	PageSize int
+	// View restricts results to a named view.
+	View string
+	// Fields limits the returned fields to this list; empty returns
+	// all fields.
+	Fields []string
+	// Sort orders the results, e.g. []string{"Name", "-CreatedTime"}
+	// where a leading "-" sorts that field descending.
+	Sort []string
+	// Filter is a filterByFormula expression, built with the formula
+	// subpackage. Its field references are validated against the
+	// record type passed to List before the request is issued.
+	Filter formula.Expr
+
+	typ    reflect.Type
+	offset string
+}
+
+func encodeOptions(options *Options) string {
+	if options == nil {
+		return ""
+	}
+
+	q := url.Values{}
+	if options.MaxRecords > 0 {
+		q.Set("maxRecords", fmt.Sprint(options.MaxRecords))
+	}
+	if options.PageSize > 0 {
+		q.Set("pageSize", fmt.Sprint(options.PageSize))
+	}
+	if options.View != "" {
+		q.Set("view", options.View)
+	}
+	if options.Filter != nil {
+		q.Set("filterByFormula", options.Filter.Compile())
+	}
+	for _, f := range options.Fields {
+		q.Add("fields[]", f)
+	}
+	for i, s := range options.Sort {
+		direction := "asc"
+		if strings.HasPrefix(s, "-") {
+			direction = "desc"
+			s = s[1:]
+		}
+		q.Set(fmt.Sprintf("sort[%d][field]", i), s)
+		q.Set(fmt.Sprintf("sort[%d][direction]", i), direction)
+	}
+	if options.offset != "" {
+		q.Set("offset", options.offset)
+	}
+	return q.Encode()
+}
@@ -0,0 +1,76 @@
+package airtable
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter is a simple token-bucket rate limiter used to keep request
+// volume under Airtable's per-base rate limit.
+type Limiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	burst    int
+	tokens   int
+	lastFill time.Time
+}
+
+// NewLimiter returns a Limiter allowing ratePerSec requests per
+// second on average, with bursts up to burst tokens.
+func NewLimiter(ratePerSec float64, burst int) *Limiter {
+	return &Limiter{
+		interval: time.Duration(float64(time.Second) / ratePerSec),
+		burst:    burst,
+		tokens:   burst,
+		lastFill: time.Now(),
+	}
+}
+
+// Wait blocks until a token is available, consuming it before
+// returning.
+func (l *Limiter) Wait() {
+	_ = l.WaitContext(context.Background())
+}
+
+// WaitContext blocks until a token is available, consuming it before
+// returning, or returns ctx.Err() if ctx is done first.
+func (l *Limiter) WaitContext(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		l.mu.Lock()
+		l.refill()
+		if l.tokens > 0 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+		wait := l.interval
+		l.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// refill must be called with l.mu held.
+func (l *Limiter) refill() {
+	elapsed := time.Since(l.lastFill)
+	add := int(elapsed / l.interval)
+	if add <= 0 {
+		return
+	}
+	l.tokens += add
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.lastFill = l.lastFill.Add(time.Duration(add) * l.interval)
+}
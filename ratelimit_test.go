@@ -0,0 +1,43 @@
+package airtable
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLimiterBurst(t *testing.T) {
+	l := NewLimiter(10, 3)
+	for i := 0; i < 3; i++ {
+		if err := l.WaitContext(context.Background()); err != nil {
+			t.Fatalf("unexpected error on token %d: %v", i, err)
+		}
+	}
+	if l.tokens != 0 {
+		t.Fatalf("tokens = %d, want 0 after exhausting the burst", l.tokens)
+	}
+}
+
+func TestLimiterRefillCapsAtBurst(t *testing.T) {
+	l := NewLimiter(10, 1) // one token every 100ms
+	l.tokens = 0
+	l.lastFill = time.Now().Add(-time.Second)
+
+	l.mu.Lock()
+	l.refill()
+	l.mu.Unlock()
+
+	if l.tokens != 1 {
+		t.Fatalf("tokens = %d, want 1 (capped at burst)", l.tokens)
+	}
+}
+
+func TestLimiterWaitContextCanceled(t *testing.T) {
+	l := NewLimiter(1, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := l.WaitContext(ctx); err == nil {
+		t.Fatal("expected an error from an already-canceled context")
+	}
+}
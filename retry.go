@@ -0,0 +1,93 @@
+package airtable
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how Client retries a failed request.
+//
+// A transport-level error (the request never got a response) is only
+// retried for idempotent methods — repeating a GET or DELETE is
+// always safe, but repeating a POST could double-create a record if
+// the original request actually reached Airtable and the response
+// was merely lost. Retries driven by an HTTP status code (see
+// Retryable) aren't affected by this, since a status code means a
+// response was received and nothing was applied twice.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the
+	// first. Values <= 0 are treated as 1 (no retries).
+	MaxAttempts int
+	// BaseDelay is the backoff delay before the second attempt,
+	// doubled on each attempt after that.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff delay.
+	MaxDelay time.Duration
+	// Retryable reports whether a response with the given status code
+	// should be retried. Defaults to 429 and 5xx when nil.
+	Retryable func(statusCode int) bool
+}
+
+// DefaultRetryPolicy retries 429 and 5xx responses up to 5 attempts
+// with exponential backoff between 200ms and 5s, honoring any
+// Retry-After header Airtable sends.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 5,
+		BaseDelay:   200 * time.Millisecond,
+		MaxDelay:    5 * time.Second,
+		Retryable:   defaultRetryable,
+	}
+}
+
+func defaultRetryable(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+func (p *RetryPolicy) retryable(statusCode int) bool {
+	if p.Retryable == nil {
+		return defaultRetryable(statusCode)
+	}
+	return p.Retryable(statusCode)
+}
+
+// isIdempotentMethod reports whether method is safe to retry after a
+// transport-level error, where it's unknown whether Airtable ever saw
+// the original request. POST creates records and isn't idempotent, so
+// it's excluded; GET, PATCH, PUT, and DELETE are all safe to repeat.
+func isIdempotentMethod(method string) bool {
+	return method != http.MethodPost
+}
+
+// backoff returns how long to wait after the given attempt (1-indexed
+// count of attempts already made) before the next one, preferring retryAfter when
+// Airtable provided one, and otherwise exponential backoff with
+// jitter.
+func (p *RetryPolicy) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+
+	delay := p.BaseDelay << uint(attempt-1)
+	if delay <= 0 || delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay)/2+1))
+}
+
+// parseRetryAfter parses a Retry-After header value, which Airtable
+// sends as either a number of seconds or an HTTP date.
+func parseRetryAfter(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(header); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(header); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
@@ -0,0 +1,80 @@
+package airtable
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestBackoffPrefersRetryAfter(t *testing.T) {
+	p := DefaultRetryPolicy()
+	got := p.backoff(1, 3*time.Second)
+	if got != 3*time.Second {
+		t.Fatalf("backoff() = %v, want 3s", got)
+	}
+}
+
+func TestBackoffGrowsAndCapsAtMaxDelay(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	for attempt, want := range map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		3: 400 * time.Millisecond,
+	} {
+		got := p.backoff(attempt, 0)
+		if got < want/2 || got > want {
+			t.Fatalf("backoff(%d, 0) = %v, want in [%v, %v]", attempt, got, want/2, want)
+		}
+	}
+
+	// Attempt 5 would be 1.6s uncapped; it should clamp to MaxDelay.
+	got := p.backoff(5, 0)
+	if got < p.MaxDelay/2 || got > p.MaxDelay {
+		t.Fatalf("backoff(5, 0) = %v, want in [%v, %v]", got, p.MaxDelay/2, p.MaxDelay)
+	}
+}
+
+func TestIsIdempotentMethod(t *testing.T) {
+	cases := map[string]bool{
+		http.MethodGet:    true,
+		http.MethodDelete: true,
+		http.MethodPatch:  true,
+		http.MethodPut:    true,
+		http.MethodPost:   false,
+	}
+	for method, want := range cases {
+		if got := isIdempotentMethod(method); got != want {
+			t.Fatalf("isIdempotentMethod(%q) = %v, want %v", method, got, want)
+		}
+	}
+}
+
+func TestParseRetryAfterSeconds(t *testing.T) {
+	got := parseRetryAfter("5")
+	if got != 5*time.Second {
+		t.Fatalf("parseRetryAfter(\"5\") = %v, want 5s", got)
+	}
+}
+
+func TestParseRetryAfterHTTPDate(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	header := future.UTC().Format(http.TimeFormat)
+
+	got := parseRetryAfter(header)
+	if got <= 0 || got > time.Hour {
+		t.Fatalf("parseRetryAfter(%q) = %v, want in (0, 1h]", header, got)
+	}
+}
+
+func TestParseRetryAfterEmpty(t *testing.T) {
+	if got := parseRetryAfter(""); got != 0 {
+		t.Fatalf("parseRetryAfter(\"\") = %v, want 0", got)
+	}
+}
+
+func TestParseRetryAfterInvalid(t *testing.T) {
+	if got := parseRetryAfter("not-a-valid-value"); got != 0 {
+		t.Fatalf("parseRetryAfter(invalid) = %v, want 0", got)
+	}
+}
@@ -1,6 +1,8 @@
 package airtable
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,7 +10,6 @@ import (
 	"net/url"
 	"path"
 	"reflect"
-	"strings"
 	"time"
 )
 
@@ -35,24 +36,34 @@ func (c *Client) Table(name string) Table {
 
 // Get returns information about a resource
 func (t *Table) Get(id string, record interface{}) error {
-	bytes, err := t.client.Request("GET", t.makePath(id), nil)
+	return t.GetContext(context.Background(), id, record)
+}
+
+// GetContext is Get with a caller-supplied context.
+func (t *Table) GetContext(ctx context.Context, id string, record interface{}) error {
+	bytes, err := t.client.RequestContext(ctx, "GET", t.makePath(id), nil)
 	if err != nil {
 		return err
 	}
-	return json.Unmarshal(bytes, record)
+	return decodeRecord(bytes, record)
 }
 
 // Update ...
 func (t *Table) Update(record interface{}) error {
-	id, err := getID(&record)
+	return t.UpdateContext(context.Background(), record)
+}
+
+// UpdateContext is Update with a caller-supplied context.
+func (t *Table) UpdateContext(ctx context.Context, record interface{}) error {
+	id, err := getID(record)
 	if err != nil {
 		return err
 	}
-	body, err := getJSONBody(&record)
+	body, err := getJSONBody(record)
 	if err != nil {
 		return err
 	}
-	_, err = t.client.RequestWithBody("PATCH", t.makePath(id), Options{}, body)
+	_, err = t.client.RequestWithBodyContext(ctx, "PATCH", t.makePath(id), &Options{}, body)
 	if err != nil {
 		return err
 	}
@@ -72,13 +83,18 @@ func NewRecord(container interface{}, data Fields) {
 	ref := reflect.ValueOf(container).Elem()
 	typ := ref.Type()
 	fields := ref.FieldByName("Fields")
+	fm := getFieldMap(fields.Type())
 	for k, v := range data {
-		f := fields.FieldByName(k)
-		val := reflect.ValueOf(v)
-		if !f.IsValid() {
+		m, ok := fm.byGoName[k]
+		if !ok {
+			m, ok = fm.byColumn[k]
+		}
+		if !ok {
 			errstr := fmt.Sprintf("cannot find field %s.%s", typ, k)
 			panic(errstr)
 		}
+		f := fields.FieldByName(m.goName)
+		val := reflect.ValueOf(v)
 		if fkind, vkind := f.Kind(), val.Kind(); fkind != vkind {
 			errstr := fmt.Sprintf("type error setting %s.%s: %s != %s", typ, k, fkind, vkind)
 			panic(errstr)
@@ -89,15 +105,20 @@ func NewRecord(container interface{}, data Fields) {
 
 // Create ...
 func (t *Table) Create(record interface{}) error {
+	return t.CreateContext(context.Background(), record)
+}
+
+// CreateContext is Create with a caller-supplied context.
+func (t *Table) CreateContext(ctx context.Context, record interface{}) error {
 	body, err := getJSONBody(record)
 	if err != nil {
 		return err
 	}
-	res, err := t.client.RequestWithBody("POST", t.makePath(""), Options{}, body)
+	res, err := t.client.RequestWithBodyContext(ctx, "POST", t.makePath(""), &Options{}, body)
 	if err != nil {
 		return err
 	}
-	return json.Unmarshal(res, record)
+	return decodeRecord(res, record)
 }
 
 type deleteResponse struct {
@@ -115,11 +136,16 @@ func (t *Table) makePath(id string) string {
 
 // Delete ...
 func (t *Table) Delete(record interface{}) error {
+	return t.DeleteContext(context.Background(), record)
+}
+
+// DeleteContext is Delete with a caller-supplied context.
+func (t *Table) DeleteContext(ctx context.Context, record interface{}) error {
 	id, err := getID(record)
 	if err != nil {
 		return err
 	}
-	res, err := t.client.Request("DELETE", t.makePath(id), Options{})
+	res, err := t.client.RequestContext(ctx, "DELETE", t.makePath(id), &Options{})
 	if err != nil {
 		return err
 	}
@@ -136,6 +162,13 @@ func (t *Table) Delete(record interface{}) error {
 
 // List returns stuff
 func (t *Table) List(listPtr interface{}, options *Options) error {
+	return t.ListContext(context.Background(), listPtr, options)
+}
+
+// ListContext is List with a caller-supplied context, which is also
+// threaded through every page of a paginated result, letting callers
+// cancel a long-running List call mid-pagination.
+func (t *Table) ListContext(ctx context.Context, listPtr interface{}, options *Options) error {
 	if options == nil {
 		options = &Options{}
 	}
@@ -143,61 +176,71 @@ func (t *Table) List(listPtr interface{}, options *Options) error {
 	oneRecord := reflect.TypeOf(listPtr).Elem().Elem()
 	options.typ = oneRecord
 
-	bytes, err := t.client.Request("GET", t.makePath(""), options)
+	if options.Filter != nil {
+		if err := validateFilterFields(oneRecord, options.Filter); err != nil {
+			return err
+		}
+	}
+
+	bytes, err := t.client.RequestContext(ctx, "GET", t.makePath(""), options)
 	if err != nil {
 		return err
 	}
 
-	responseType := reflect.StructOf([]reflect.StructField{
-		{Name: "Records", Type: reflect.TypeOf(listPtr).Elem()},
-		{Name: "Offset", Type: reflect.TypeOf("")},
-	})
-
-	container := reflect.New(responseType)
-	err = json.Unmarshal(bytes, container.Interface())
-	if err != nil {
+	var page struct {
+		Records []json.RawMessage `json:"records"`
+		Offset  string            `json:"offset"`
+	}
+	if err := json.Unmarshal(bytes, &page); err != nil {
 		return err
 	}
 
-	recordList := container.Elem().FieldByName("Records")
 	list := reflect.ValueOf(listPtr).Elem()
-	for i := 0; i < recordList.Len(); i++ {
-		entry := recordList.Index(i)
-		list = reflect.Append(list, entry)
+	for _, raw := range page.Records {
+		entry := reflect.New(oneRecord)
+		if err := decodeRecord(raw, entry.Interface()); err != nil {
+			return err
+		}
+		list = reflect.Append(list, entry.Elem())
 	}
 	reflect.ValueOf(listPtr).Elem().Set(list)
 
-	offset := container.Elem().FieldByName("Offset").String()
-	if offset != "" {
-		options.offset = offset
-		return t.List(listPtr, options)
+	if page.Offset != "" {
+		options.offset = page.Offset
+		return t.ListContext(ctx, listPtr, options)
 	}
 	return nil
 }
 
 func getJSONBody(r interface{}) (io.Reader, error) {
-	f, err := getFields(r)
+	cols, typecast, err := getFields(r)
 	if err != nil {
 		return nil, err
 	}
-	b, err := json.Marshal(f)
+	payload := map[string]interface{}{"fields": cols}
+	if typecast {
+		payload["typecast"] = true
+	}
+	b, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
 	}
-	jsonstr := fmt.Sprintf(`{"fields": %s}`, b)
-	body := strings.NewReader(jsonstr)
-	return body, nil
+	return bytes.NewReader(b), nil
 }
 
-func getFields(e interface{}) (interface{}, error) {
+// getFields returns e's Fields struct rendered as Airtable column
+// name to value, plus whether Airtable's typecast option should be
+// set, per the record's airtable:"..." tags.
+func getFields(e interface{}) (map[string]interface{}, bool, error) {
 	fields := reflect.ValueOf(e).Elem().FieldByName("Fields")
 	if !fields.IsValid() {
-		return nil, errors.New("getFields: missing Fields")
+		return nil, false, errors.New("getFields: missing Fields")
 	}
 	if fields.Kind() != reflect.Struct {
-		return nil, errors.New("getFields: Fields not a struct")
+		return nil, false, errors.New("getFields: Fields not a struct")
 	}
-	return fields.Interface(), nil
+	cols, typecast := encodeFields(fields)
+	return cols, typecast, nil
 }
 
 func getID(e interface{}) (string, error) {
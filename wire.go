@@ -0,0 +1,61 @@
+package airtable
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// wireRecord is the shape Airtable sends a record in: Fields kept as
+// raw JSON so each value can be unmarshaled individually once we know
+// which Go field its column maps to.
+type wireRecord struct {
+	ID          string                     `json:"id"`
+	CreatedTime time.Time                  `json:"createdTime"`
+	Fields      map[string]json.RawMessage `json:"fields"`
+}
+
+// decodeRecord unmarshals a single Airtable record into record (a
+// pointer to a struct embedding Record and Fields), mapping each
+// column through record's Fields fieldMap instead of relying on
+// encoding/json's exact-name matching, so `airtable:"Column Name"`
+// tags are honored.
+func decodeRecord(raw []byte, record interface{}) error {
+	var wire wireRecord
+	if err := json.Unmarshal(raw, &wire); err != nil {
+		return err
+	}
+
+	ref := reflect.ValueOf(record).Elem()
+
+	id := ref.FieldByName("ID")
+	if !id.IsValid() || id.Kind() != reflect.String {
+		return errors.New("decodeRecord: missing or non-string ID")
+	}
+	id.SetString(wire.ID)
+
+	createdTime := ref.FieldByName("CreatedTime")
+	if !createdTime.IsValid() || createdTime.Type() != reflect.TypeOf(time.Time{}) {
+		return errors.New("decodeRecord: missing or non-time.Time CreatedTime")
+	}
+	createdTime.Set(reflect.ValueOf(wire.CreatedTime))
+
+	fieldsVal := ref.FieldByName("Fields")
+	if !fieldsVal.IsValid() || fieldsVal.Kind() != reflect.Struct {
+		return errors.New("decodeRecord: missing Fields")
+	}
+	fm := getFieldMap(fieldsVal.Type())
+	for _, m := range fm.ordered {
+		raw, ok := wire.Fields[m.column]
+		if !ok {
+			continue
+		}
+		target := fieldsVal.FieldByName(m.goName)
+		if err := json.Unmarshal(raw, target.Addr().Interface()); err != nil {
+			return fmt.Errorf("airtable: decoding field %q: %w", m.column, err)
+		}
+	}
+	return nil
+}